@@ -15,7 +15,7 @@ MANIFEST="beacon-distro/manifest.yaml"
 
 # Auto-discover go.mod files and Containerfiles with Go base images
 mapfile -t GO_MODS < <(find . -name go.mod -not -path '*/vendor/*' -print | sort || true)
-mapfile -t CONTAINERFILES < <(grep -rl '^FROM golang:' . --include='Containerfile*' --include='Dockerfile*' 2>/dev/null | sort || true)
+mapfile -t CONTAINERFILES < <(grep -rlE '^FROM (--platform=[^ ]+ )?golang:' . --include='Containerfile*' --include='Dockerfile*' 2>/dev/null | sort || true)
 
 # Workspace modules (from go.work use block) — these carry OTel deps
 mapfile -t WORKSPACE_MODULES < <(sed -n '/^use (/,/^)/{ s/^[[:space:]]*\.\///p }' "$GO_WORK" || true)
@@ -44,7 +44,7 @@ for GOMOD in "${GO_MODS[@]}"; do
 done
 
 for CF in "${CONTAINERFILES[@]}"; do
-	CF_VERSION=$(sed -n 's/^FROM golang:\([0-9]*\.[0-9]*\.[0-9]*\).*/\1/p' "$CF" | head -1)
+	CF_VERSION=$(sed -n 's/^FROM \(--platform=[^ ]* \)\{0,1\}golang:\([0-9]*\.[0-9]*\.[0-9]*\).*/\2/p' "$CF" | head -1)
 	if [[ -z "$CF_VERSION" ]]; then
 		echo "  WARNING: Could not extract Go version from $CF"
 		continue
@@ -149,7 +149,7 @@ done
 
 MANIFEST_FAIL=0
 
-MANIFEST_VERSIONS=$(grep -E 'go\.opentelemetry\.io/collector/(exporter|processor|receiver)' "$MANIFEST" |
+MANIFEST_VERSIONS=$(grep -E 'go\.opentelemetry\.io/collector/(exporter|processor|receiver|connector)' "$MANIFEST" |
 	grep -v '^\s*#' |
 	grep -oE 'v[0-9]+\.[0-9]+\.[0-9]+' | sort -u || true)
 
@@ -19,7 +19,7 @@ MANIFEST="beacon-distro/manifest.yaml"
 
 # Auto-discover go.mod files and Containerfiles with Go base images
 mapfile -t GO_MODS < <(find . -name go.mod -not -path '*/vendor/*' -print | sort || true)
-mapfile -t CONTAINERFILES < <(grep -rl '^FROM golang:' . --include='Containerfile*' --include='Dockerfile*' 2>/dev/null | sort || true)
+mapfile -t CONTAINERFILES < <(grep -rlE '^FROM (--platform=[^ ]+ )?golang:' . --include='Containerfile*' --include='Dockerfile*' 2>/dev/null | sort || true)
 
 # Workspace modules (from go.work use block) — these carry OTel deps
 mapfile -t WORKSPACE_MODULES < <(sed -n '/^use (/,/^)/{ s/^[[:space:]]*\.\///p }' "$GO_WORK" || true)
@@ -43,7 +43,7 @@ done
 
 # ── Sync Containerfile Go image tags ─────────────────────────────
 for CF in "${CONTAINERFILES[@]}"; do
-	perl -i -pe "s{FROM golang:\d+\.\d+\.\d+}{FROM golang:$GO_VERSION}" "$CF"
+	perl -i -pe "s{^(FROM (?:--platform=\S+ )?golang:)\d+\.\d+\.\d+}{\${1}$GO_VERSION}" "$CF"
 	echo "  Containerfile: $CF"
 done
 
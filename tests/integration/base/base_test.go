@@ -44,6 +44,19 @@ var _ = Describe("Base Layer", func() {
 		})
 	})
 
+	Describe("Loki Labels", func() {
+		It("indexes severity as a stream label", func() {
+			resp, err := integration.PostEvidence(webhookURL, "../fixtures/evidence-fail.json")
+			Expect(err).NotTo(HaveOccurred())
+			defer resp.Body.Close()
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+
+			Eventually(func() ([]string, error) {
+				return integration.QueryLoki(lokiURL, `{policy_rule_id="github_branch_protection", severity="unknown"}`)
+			}, 30*time.Second, 3*time.Second).ShouldNot(BeEmpty())
+		})
+	})
+
 	Describe("Resilience", func() {
 		It("survives malformed evidence without disruption", func() {
 			resp, err := integration.PostEvidence(webhookURL, "../fixtures/evidence-malformed.json")
@@ -140,6 +140,36 @@ func ListS3Objects(s3URL, bucket, prefix string) ([]string, error) {
 	return keys, nil
 }
 
+// ListS3ObjectsContaining returns the keys under prefix whose object content
+// contains substr. Objects are fetched via plain HTTP GET (anonymous access),
+// which lets tests tell apart evidence that lands under the same prefix.
+func ListS3ObjectsContaining(s3URL, bucket, prefix, substr string) ([]string, error) {
+	keys, err := ListS3Objects(s3URL, bucket, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []string
+	for _, key := range keys {
+		resp, err := HTTPClient.Get(fmt.Sprintf("%s/%s/%s", s3URL, bucket, key))
+		if err != nil {
+			return nil, fmt.Errorf("fetching S3 object %s: %w", key, err)
+		}
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("reading S3 object %s: %w", key, err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("S3 returned status %d for %s: %s", resp.StatusCode, key, string(body))
+		}
+		if strings.Contains(string(body), substr) {
+			matches = append(matches, key)
+		}
+	}
+	return matches, nil
+}
+
 // ExecInContainer runs a command inside a running compose service container using
 // podman-compose exec. Returns combined stdout+stderr output. The compose project
 // is determined by the working directory (repo root is expected).
@@ -270,6 +300,34 @@ func MintDexToken(dexURL, clientID, username, password string) (string, error) {
 // If bearerToken is non-empty, it is attached as gRPC metadata (authorization: Bearer <token>).
 // Returns the gRPC error (nil on success).
 func PostEvidenceOTLP(otlpAddr, fixturePath, bearerToken string) error {
+	return postEvidenceOTLP(otlpAddr, fixturePath, bearerToken, nil)
+}
+
+// PostEvidenceOTLPWithFrameworks sends a fixture like PostEvidenceOTLP, tagging the
+// log record with a compliance.frameworks string array attribute so routing
+// conditions on the framework can be exercised.
+func PostEvidenceOTLPWithFrameworks(otlpAddr, fixturePath string, frameworks []string) error {
+	values := make([]*commonpb.AnyValue, 0, len(frameworks))
+	for _, f := range frameworks {
+		values = append(values, &commonpb.AnyValue{
+			Value: &commonpb.AnyValue_StringValue{StringValue: f},
+		})
+	}
+
+	attrs := []*commonpb.KeyValue{
+		{
+			Key: "compliance.frameworks",
+			Value: &commonpb.AnyValue{
+				Value: &commonpb.AnyValue_ArrayValue{
+					ArrayValue: &commonpb.ArrayValue{Values: values},
+				},
+			},
+		},
+	}
+	return postEvidenceOTLP(otlpAddr, fixturePath, "", attrs)
+}
+
+func postEvidenceOTLP(otlpAddr, fixturePath, bearerToken string, attrs []*commonpb.KeyValue) error {
 	body, err := os.ReadFile(fixturePath)
 	if err != nil {
 		return fmt.Errorf("reading fixture %s: %w", fixturePath, err)
@@ -298,6 +356,7 @@ func PostEvidenceOTLP(otlpAddr, fixturePath, bearerToken string) error {
 										StringValue: string(body),
 									},
 								},
+								Attributes: attrs,
 							},
 						},
 					},
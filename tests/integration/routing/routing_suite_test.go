@@ -0,0 +1,33 @@
+package routing_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/complytime/complybeacon/tests/integration"
+)
+
+var (
+	webhookURL string
+	lokiURL    string
+	otlpAddr   string
+	s3URL      string
+	s3Bucket   string
+)
+
+func TestRouting(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Routing Layer Suite")
+}
+
+var _ = BeforeSuite(func() {
+	webhookURL = integration.EnvOrDefault("WEBHOOK_URL", "http://localhost:8088")
+	lokiURL = integration.EnvOrDefault("LOKI_URL", "http://localhost:3100")
+	otlpAddr = integration.EnvOrDefault("OTLP_ADDR", "localhost:14317")
+	s3URL = integration.EnvOrDefault("S3_URL", "http://localhost:9000")
+	s3Bucket = integration.EnvOrDefault("S3_BUCKET", "complybeacon-evidence")
+
+	Expect(integration.CheckStackRunning(webhookURL, "routing")).To(Succeed())
+})
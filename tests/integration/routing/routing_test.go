@@ -0,0 +1,72 @@
+package routing_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/complytime/complybeacon/tests/integration"
+)
+
+var _ = Describe("Routing Layer", func() {
+	Describe("Framework Routing", func() {
+		It("archives evidence tagged with several frameworks in each store", func() {
+			err := integration.PostEvidenceOTLPWithFrameworks(otlpAddr, "../fixtures/evidence-fail.json",
+				[]string{"PCI-DSS", "SOC2"})
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(func() ([]string, error) {
+				return integration.ListS3ObjectsContaining(s3URL, s3Bucket, "evidence/pci/", "github_branch_protection")
+			}, 30*time.Second, 3*time.Second).ShouldNot(BeEmpty())
+
+			Eventually(func() ([]string, error) {
+				return integration.ListS3ObjectsContaining(s3URL, s3Bucket, "evidence/soc2/", "github_branch_protection")
+			}, 30*time.Second, 3*time.Second).ShouldNot(BeEmpty())
+		})
+
+		It("archives single-framework evidence only in its own store", func() {
+			err := integration.PostEvidenceOTLPWithFrameworks(otlpAddr, "../fixtures/evidence-pass.json",
+				[]string{"SOC2"})
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(func() ([]string, error) {
+				return integration.ListS3ObjectsContaining(s3URL, s3Bucket, "evidence/soc2/", "code_review")
+			}, 30*time.Second, 3*time.Second).ShouldNot(BeEmpty())
+
+			Consistently(func() ([]string, error) {
+				return integration.ListS3ObjectsContaining(s3URL, s3Bucket, "evidence/pci/", "code_review")
+			}, 10*time.Second, 2*time.Second).Should(BeEmpty())
+		})
+
+		It("does not archive evidence for unrouted frameworks", func() {
+			err := integration.PostEvidenceOTLPWithFrameworks(otlpAddr, "../fixtures/evidence-unknown.json",
+				[]string{"NIST-800-53"})
+			Expect(err).NotTo(HaveOccurred())
+
+			// Wait until the record has been through the pipeline before
+			// asserting it was not archived.
+			Eventually(func() ([]string, error) {
+				return integration.QueryLoki(lokiURL, `{policy_rule_id="unknown_policy_xyz"}`)
+			}, 30*time.Second, 3*time.Second).ShouldNot(BeEmpty())
+
+			Consistently(func() ([]string, error) {
+				return integration.ListS3ObjectsContaining(s3URL, s3Bucket, "evidence/pci/", "unknown_policy_xyz")
+			}, 10*time.Second, 2*time.Second).Should(BeEmpty())
+
+			Consistently(func() ([]string, error) {
+				return integration.ListS3ObjectsContaining(s3URL, s3Bucket, "evidence/soc2/", "unknown_policy_xyz")
+			}, 10*time.Second, 2*time.Second).Should(BeEmpty())
+		})
+
+		It("keeps routed evidence queryable in Loki", func() {
+			err := integration.PostEvidenceOTLPWithFrameworks(otlpAddr, "../fixtures/evidence-pass.json",
+				[]string{"SOC2"})
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(func() ([]string, error) {
+				return integration.QueryLoki(lokiURL, `{policy_rule_id="code_review"}`)
+			}, 30*time.Second, 3*time.Second).ShouldNot(BeEmpty())
+		})
+	})
+})